@@ -0,0 +1,24 @@
+package api
+
+import "context"
+
+// API is the surface that core.CLI and commands depend on instead of the
+// concrete *httpClient, so tests can substitute a fake (see the apitest
+// subpackage).
+type API interface {
+	Request(ctx context.Context, method, uri string, payload interface{}) error
+	URI(path ...string) string
+}
+
+// ApplicationsAPI is the resource-specific surface used by the
+// `applications` command family.
+type ApplicationsAPI interface {
+	API
+	Paginator
+	ListApplications(ctx context.Context) ([]Application, error)
+}
+
+var (
+	_ API             = (*httpClient)(nil)
+	_ ApplicationsAPI = (*httpClient)(nil)
+)
@@ -0,0 +1,138 @@
+// Package apitest provides an in-memory fake of api.API for unit tests,
+// mirroring the mock-auth-client pattern used by the Vespa CLI: callers
+// program canned JSON responses per URI and assert on the calls recorded.
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+)
+
+// Call records a single Request invocation, or a single page served by
+// Paginate, made against the fake.
+type Call struct {
+	Method  string
+	URI     string
+	Payload interface{}
+	// Opts is populated for Paginate calls, so tests can assert that
+	// flags like --limit were actually threaded through.
+	Opts api.PaginateOptions
+}
+
+// FakeAPI is an in-memory api.API implementation for tests.
+type FakeAPI struct {
+	BasePath string
+
+	Calls     []Call
+	responses map[string]string
+	pages     map[string][]string
+	errors    map[string]error
+}
+
+var _ api.ApplicationsAPI = (*FakeAPI)(nil)
+
+// New creates an empty FakeAPI.
+func New() *FakeAPI {
+	return &FakeAPI{
+		responses: map[string]string{},
+		pages:     map[string][]string{},
+		errors:    map[string]error{},
+	}
+}
+
+// RespondWith programs the fake to unmarshal respJSON into the payload of
+// the next Request call made against uri, and to serve it as the only page
+// for Paginate.
+func (f *FakeAPI) RespondWith(uri, respJSON string) {
+	f.responses[uri] = respJSON
+	f.pages[uri] = []string{respJSON}
+}
+
+// RespondWithPages programs Paginate to walk through pagesJSON, in order,
+// the next time it's called against uri. Use this to exercise --all /
+// multi-page behaviour; Request/ListApplications still only ever see the
+// first page.
+func (f *FakeAPI) RespondWithPages(uri string, pagesJSON ...string) {
+	f.pages[uri] = pagesJSON
+	if len(pagesJSON) > 0 {
+		f.responses[uri] = pagesJSON[0]
+	}
+}
+
+// FailWith programs the fake to return err for any Request or Paginate
+// call made against uri.
+func (f *FakeAPI) FailWith(uri string, err error) {
+	f.errors[uri] = err
+}
+
+// Request records the call and, if programmed, unmarshals the canned
+// response into payload or returns the canned error.
+func (f *FakeAPI) Request(ctx context.Context, method, uri string, payload interface{}) error {
+	f.Calls = append(f.Calls, Call{Method: method, URI: uri, Payload: payload})
+
+	if err, ok := f.errors[uri]; ok {
+		return err
+	}
+	respJSON, ok := f.responses[uri]
+	if !ok {
+		return fmt.Errorf("apitest: no response programmed for %s %s", method, uri)
+	}
+	if err := json.Unmarshal([]byte(respJSON), payload); err != nil {
+		return fmt.Errorf("apitest: failed to unmarshal programmed response for %s: %w", uri, err)
+	}
+	return nil
+}
+
+// ListApplications fetches applications through Request, the same way
+// httpClient.ListApplications does, so programming the "applications" URI
+// response exercises the same path as the real client.
+func (f *FakeAPI) ListApplications(ctx context.Context) ([]api.Application, error) {
+	var response struct {
+		Applications []api.Application `json:"applications"`
+	}
+	if err := f.Request(ctx, "GET", f.URI("applications"), &response); err != nil {
+		return nil, err
+	}
+	return response.Applications, nil
+}
+
+// Paginate walks the pages programmed via RespondWith/RespondWithPages for
+// uri, invoking fn once per page. When opts.All is false it stops after the
+// first page, matching httpClient.Paginate.
+func (f *FakeAPI) Paginate(ctx context.Context, uri string, opts api.PaginateOptions, fn func(page json.RawMessage) error) error {
+	if err, ok := f.errors[uri]; ok {
+		f.Calls = append(f.Calls, Call{Method: "GET", URI: uri, Opts: opts})
+		return err
+	}
+	pages, ok := f.pages[uri]
+	if !ok {
+		return fmt.Errorf("apitest: no response programmed for GET %s", uri)
+	}
+	for _, pageJSON := range pages {
+		f.Calls = append(f.Calls, Call{Method: "GET", URI: uri, Opts: opts})
+		if err := fn(json.RawMessage(pageJSON)); err != nil {
+			return err
+		}
+		if !opts.All {
+			return nil
+		}
+	}
+	return nil
+}
+
+// URI mirrors httpClient.URI closely enough for tests: it joins path
+// segments under BasePath without escaping, since test fixtures don't need
+// the percent-encoding used against the real API.
+func (f *FakeAPI) URI(path ...string) string {
+	uri := f.BasePath
+	for _, segment := range path {
+		if uri != "" {
+			uri += "/"
+		}
+		uri += segment
+	}
+	return uri
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// Application is a single Asgardeo application registration as returned by
+// GET /applications.
+type Application struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	ClientID     string `json:"clientId"`
+	AccessURL    string `json:"accessUrl"`
+	TemplateID   string `json:"templateId"`
+	IsManagedApp bool   `json:"isManagementApp"`
+}
+
+type listApplicationsResponse struct {
+	Applications []Application `json:"applications"`
+}
+
+// ListApplications fetches the applications registered in the tenant with a
+// single GET; only the first page the server returns is read, further pages
+// are not followed.
+func (c *httpClient) ListApplications(ctx context.Context) ([]Application, error) {
+	var response listApplicationsResponse
+	if err := c.Request(ctx, "GET", c.URI("applications"), &response); err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	return response.Applications, nil
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Error is a structured error returned by Asgardeo when a request fails,
+// built from the standard `{"code","message","description","traceId"}`
+// error body the management APIs share.
+type Error struct {
+	StatusCode  int    `json:"-"`
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	Description string `json:"description"`
+	TraceID     string `json:"traceId"`
+}
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("asgardeo api: %d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("asgardeo api: unexpected status %d", e.StatusCode)
+}
+
+// newError builds an *Error from an error HTTP response, reading and
+// closing resp.Body. The body is best-effort JSON: a non-JSON or empty
+// body still yields a usable error carrying the status code.
+func newError(resp *http.Response) error {
+	apiErr := &Error{StatusCode: resp.StatusCode}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+	_ = json.Unmarshal(body, apiErr)
+	return apiErr
+}
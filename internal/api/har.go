@@ -0,0 +1,207 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// harLog is the root of a HAR 1.2 document (http://www.softwareishard.com/blog/har-12-spec/).
+type harLog struct {
+	Log harLogBody `json:"log"`
+}
+
+type harLogBody struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string         `json:"startedDateTime"`
+	Time            float64        `json:"time"`
+	Request         harRequest     `json:"request"`
+	Response        harResponse    `json:"response"`
+	Cache           map[string]any `json:"cache"`
+	Timings         harTimings     `json:"timings"`
+}
+
+type harRequest struct {
+	Method      string     `json:"method"`
+	URL         string     `json:"url"`
+	HTTPVersion string     `json:"httpVersion"`
+	Headers     []harField `json:"headers"`
+	BodySize    int        `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int        `json:"status"`
+	HTTPVersion string     `json:"httpVersion"`
+	Headers     []harField `json:"headers"`
+	Content     harContent `json:"content"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// HARWriter accumulates TraceEntry values and writes them out as a HAR 1.2
+// archive. It is safe for concurrent use; Flush can be called repeatedly
+// (e.g. on every entry, and again on signal cancellation) so a partial
+// trace still survives a Ctrl-C.
+type HARWriter struct {
+	mu      sync.Mutex
+	path    string
+	entries []harEntry
+}
+
+// NewHARWriter creates a HARWriter that flushes to path.
+func NewHARWriter(path string) *HARWriter {
+	return &HARWriter{path: path}
+}
+
+// Trace implements Tracer by recording entry and flushing to disk.
+func (w *HARWriter) Trace(entry TraceEntry) {
+	w.mu.Lock()
+	w.entries = append(w.entries, toHAREntry(entry))
+	w.mu.Unlock()
+
+	if err := w.Flush(); err != nil {
+		fmt.Fprintf(os.Stderr, "asgardeo: failed to flush HAR trace: %v\n", err)
+	}
+}
+
+// Flush writes every entry recorded so far to w.path.
+func (w *HARWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	file, err := os.Create(w.path)
+	if err != nil {
+		return fmt.Errorf("failed to open HAR file: %w", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	document := harLog{Log: harLogBody{
+		Version: "1.2",
+		Creator: harCreator{Name: "asgardeo-cli", Version: "v0.0.1"},
+		Entries: w.entries,
+	}}
+	if err := json.NewEncoder(writer).Encode(document); err != nil {
+		return fmt.Errorf("failed to encode HAR document: %w", err)
+	}
+	return writer.Flush()
+}
+
+func toHAREntry(entry TraceEntry) harEntry {
+	method, url, headers := parseDump(entry.RequestDump)
+	_, _, respHeaders := parseDump(entry.Response)
+
+	return harEntry{
+		StartedDateTime: entry.StartedAt.Format("2006-01-02T15:04:05.000Z07:00"),
+		Time:            float64(entry.Duration.Milliseconds()),
+		Request: harRequest{
+			Method:      valueOr(method, entry.Method),
+			URL:         valueOr(url, entry.URL),
+			HTTPVersion: "HTTP/1.1",
+			Headers:     toHARFields(headers),
+			BodySize:    len(entry.RequestDump),
+		},
+		Response: harResponse{
+			Status:      entry.StatusCode,
+			HTTPVersion: "HTTP/1.1",
+			Headers:     toHARFields(respHeaders),
+			Content: harContent{
+				Size:     len(entry.Response),
+				MimeType: "application/json",
+				Text:     string(entry.Response),
+			},
+		},
+		Cache: map[string]any{},
+	}
+}
+
+// parseDump extracts the request line and headers from a raw
+// httputil.DumpRequestOut/DumpResponse dump, best-effort.
+func parseDump(dump []byte) (method, url string, headers http.Header) {
+	headers = http.Header{}
+	lines := splitLines(dump)
+	if len(lines) == 0 {
+		return "", "", headers
+	}
+	fields := splitFields(lines[0])
+	if len(fields) >= 2 {
+		method, url = fields[0], fields[1]
+	}
+	for _, line := range lines[1:] {
+		name, value, ok := splitHeaderLine(line)
+		if ok {
+			headers.Add(name, value)
+		}
+	}
+	return method, url, headers
+}
+
+func toHARFields(headers http.Header) []harField {
+	var fields []harField
+	for name, values := range headers {
+		for _, value := range values {
+			fields = append(fields, harField{Name: name, Value: value})
+		}
+	}
+	return fields
+}
+
+func valueOr(v, fallback string) string {
+	if v != "" {
+		return v
+	}
+	return fallback
+}
+
+func splitLines(dump []byte) []string {
+	var lines []string
+	for _, line := range bytes.Split(bytes.ReplaceAll(dump, []byte("\r\n"), []byte("\n")), []byte("\n")) {
+		if len(line) == 0 {
+			break
+		}
+		lines = append(lines, string(line))
+	}
+	return lines
+}
+
+func splitFields(line string) []string {
+	return strings.Fields(line)
+}
+
+func splitHeaderLine(line string) (name, value string, ok bool) {
+	idx := strings.Index(line, ":")
+	if idx < 0 {
+		return "", "", false
+	}
+	return strings.TrimSpace(line[:idx]), strings.TrimSpace(line[idx+1:]), true
+}
@@ -9,32 +9,120 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/shashimalcse/asgardeo-cli/internal/config"
 	"go.uber.org/zap"
 )
 
+// tokenExpiryMargin is how far ahead of the locally tracked expiry we
+// proactively refresh, so hot request loops don't have to eat a 401 first.
+const tokenExpiryMargin = 30 * time.Second
+
 type httpClient struct {
 	client   *http.Client
 	baseUrl  *url.URL
 	basepath string
-	token    string
 	logger   *zap.Logger
+
+	cfg          *config.Config
+	tenantDomain string
+
+	tokenMu      sync.Mutex
+	token        string
+	refreshToken string
+	clientID     string
+	clientSecret string
+	tokenURL     string
+	expiresAt    time.Time
+
+	retryConfig RetryConfig
+	tracer      Tracer
+}
+
+// tokenResponse mirrors the OAuth2 token endpoint response for both the
+// initial login and the refresh_token grant.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+	Scope        string `json:"scope"`
+}
+
+// Option customizes the httpClient built by NewHTTPClientAPI.
+type Option func(*httpClient)
+
+// WithRetry overrides the default transport-level retry behaviour. Commands
+// that do bulk imports can raise the limits while interactive commands keep
+// the defaults.
+func WithRetry(cfg RetryConfig) Option {
+	return func(c *httpClient) {
+		c.retryConfig = cfg
+	}
 }
 
-func NewHTTPClientAPI(cfg *config.Config, tenantDomain string, logger *zap.Logger) (*httpClient, error) {
+// WithTimeout overrides the default http.Client timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *httpClient) {
+		c.client.Timeout = timeout
+	}
+}
+
+func NewHTTPClientAPI(cfg *config.Config, tenantDomain string, logger *zap.Logger, opts ...Option) (*httpClient, error) {
 	tenant, err := cfg.GetTenant(tenantDomain)
 	if err != nil {
 		logger.Error("failed to get tenant while creating http client", zap.Error(err))
 		return nil, err
 	}
-	basepath := "t/" + tenant.Name + "/api/server/v1"
-	u, err := url.Parse("https://api.asgardeo.io/")
+	pathTemplate := tenant.APIPathTemplate
+	if pathTemplate == "" {
+		pathTemplate = config.DefaultAPIPathTemplate
+	}
+	basepath := strings.ReplaceAll(pathTemplate, "{tenant}", tenant.Name)
+
+	rawBaseURL := tenant.BaseURL
+	if rawBaseURL == "" {
+		rawBaseURL, _ = config.ResolveBaseURL("prod")
+	}
+	u, err := url.Parse(rawBaseURL)
 	if err != nil {
 		logger.Error("failed to parse base URL while creating http client", zap.Error(err))
 		return nil, err
 	}
-	return &httpClient{client: &http.Client{}, basepath: basepath, baseUrl: u, token: tenant.GetAccessToken(), logger: logger}, nil
+
+	c := &httpClient{
+		client:       &http.Client{},
+		basepath:     basepath,
+		baseUrl:      u,
+		logger:       logger,
+		cfg:          cfg,
+		tenantDomain: tenant.Name,
+		token:        tenant.GetAccessToken(),
+		refreshToken: tenant.RefreshToken,
+		clientID:     tenant.ClientID,
+		clientSecret: tenant.ClientSecret,
+		tokenURL:     tenant.TokenURL,
+		expiresAt:    tenant.TokenExpiresAt,
+		retryConfig:  DefaultRetryConfig,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	transport := c.client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if c.tracer != nil {
+		transport = newTracingRoundTripper(transport, c.tracer)
+	}
+	transport = newRetryRoundTripper(transport, c.retryConfig, logger)
+	c.client.Transport = transport
+
+	return c, nil
 }
 
 func (c *httpClient) Request(ctx context.Context, method, uri string, payload interface{}) error {
@@ -85,8 +173,12 @@ func (c *httpClient) NewRequest(ctx context.Context, method, uri string, payload
 
 func (c *httpClient) Do(req *http.Request) (*http.Response, error) {
 	ctx := req.Context()
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	response, err := c.client.Do(req)
+
+	if err := c.refreshIfExpiring(ctx); err != nil {
+		c.logger.Error("proactive token refresh failed, continuing with current token", zap.Error(err))
+	}
+
+	response, err := c.doWithToken(req)
 	if err != nil {
 		select {
 		case <-ctx.Done():
@@ -95,9 +187,138 @@ func (c *httpClient) Do(req *http.Request) (*http.Response, error) {
 			return nil, err
 		}
 	}
+
+	if response.StatusCode == http.StatusUnauthorized {
+		response.Body.Close()
+		if refreshErr := c.refresh(ctx); refreshErr != nil {
+			return nil, fmt.Errorf("received 401 and failed to refresh access token: %w", refreshErr)
+		}
+		retryReq, err := cloneRequest(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to clone request for retry: %w", err)
+		}
+		return c.doWithToken(retryReq)
+	}
+
 	return response, nil
 }
 
+// doWithToken attaches the current bearer token and sends the request.
+func (c *httpClient) doWithToken(req *http.Request) (*http.Response, error) {
+	c.tokenMu.Lock()
+	token := c.token
+	c.tokenMu.Unlock()
+	req.Header.Set("Authorization", "Bearer "+token)
+	return c.client.Do(req)
+}
+
+// refreshIfExpiring refreshes the access token when it is within
+// tokenExpiryMargin of the locally tracked expiry, avoiding the racey
+// 401-then-retry path for hot request loops.
+func (c *httpClient) refreshIfExpiring(ctx context.Context) error {
+	c.tokenMu.Lock()
+	expiresAt := c.expiresAt
+	c.tokenMu.Unlock()
+	if expiresAt.IsZero() || time.Until(expiresAt) > tokenExpiryMargin {
+		return nil
+	}
+	return c.refresh(ctx)
+}
+
+// refresh obtains a new access token and persists it back into
+// config.Config. It prefers the refresh_token grant, but `asgardeo login`
+// authenticates via client_credentials, a grant that Asgardeo does not
+// issue a refresh token for, so when there is none stored it falls back to
+// re-running the client_credentials exchange with the tenant's stored
+// client id/secret instead of hard-failing every request once the access
+// token expires.
+func (c *httpClient) refresh(ctx context.Context) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.tokenURL == "" {
+		return fmt.Errorf("no token endpoint configured for tenant %q", c.tenantDomain)
+	}
+
+	var tokens TokenResult
+	var err error
+	if c.refreshToken != "" {
+		tokens, err = c.refreshWithRefreshToken(ctx)
+	} else if c.clientID != "" && c.clientSecret != "" {
+		tokens, err = FetchClientCredentialsToken(ctx, c.tokenURL, c.clientID, c.clientSecret)
+	} else {
+		return fmt.Errorf("no refresh token or client credentials configured for tenant %q", c.tenantDomain)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.token = tokens.AccessToken
+	if tokens.RefreshToken != "" {
+		c.refreshToken = tokens.RefreshToken
+	}
+	c.expiresAt = time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+
+	if c.cfg != nil {
+		if err := c.cfg.UpdateTenantTokens(c.tenantDomain, c.token, c.refreshToken, c.expiresAt); err != nil {
+			c.logger.Error("failed to persist refreshed tokens", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// refreshWithRefreshToken performs a refresh_token grant against the
+// tenant's token endpoint.
+func (c *httpClient) refreshWithRefreshToken(ctx context.Context) (TokenResult, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", c.refreshToken)
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return TokenResult{}, fmt.Errorf("failed to create token refresh request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return TokenResult{}, fmt.Errorf("failed to send token refresh request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return TokenResult{}, newError(resp)
+	}
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return TokenResult{}, fmt.Errorf("failed to decode token refresh response: %w", err)
+	}
+
+	return TokenResult{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
+	}, nil
+}
+
+// cloneRequest creates a copy of req with a rewound body so it can be
+// safely retried.
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
 func (c *httpClient) URI(path ...string) string {
 	baseURL := &url.URL{
 		Scheme: c.baseUrl.Scheme,
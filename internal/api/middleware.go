@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RetryConfig controls the retry/backoff behaviour of the transport-level
+// retry middleware installed on httpClient.client.Transport.
+type RetryConfig struct {
+	// MaxRetries caps the number of retry attempts after the initial
+	// request.
+	MaxRetries int
+	// MaxElapsed caps the total wall-clock time spent retrying a single
+	// request, across all attempts.
+	MaxElapsed time.Duration
+	// BaseDelay is the starting backoff delay; each attempt doubles it
+	// before adding jitter.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay.
+	MaxDelay time.Duration
+	// OnRetry, if set, is invoked before each retry attempt.
+	OnRetry func(method, uri string, attempt int, delay time.Duration)
+}
+
+// DefaultRetryConfig is used when a client is constructed without
+// WithRetry.
+var DefaultRetryConfig = RetryConfig{
+	MaxRetries: 3,
+	MaxElapsed: 30 * time.Second,
+	BaseDelay:  250 * time.Millisecond,
+	MaxDelay:   5 * time.Second,
+}
+
+// retryRoundTripper wraps an http.RoundTripper with exponential backoff and
+// jitter on transient transport errors, 502/503/504 and 429 (honoring
+// Retry-After).
+type retryRoundTripper struct {
+	next   http.RoundTripper
+	config RetryConfig
+	logger *zap.Logger
+}
+
+func newRetryRoundTripper(next http.RoundTripper, cfg RetryConfig, logger *zap.Logger) *retryRoundTripper {
+	return &retryRoundTripper{next: next, config: cfg, logger: logger}
+}
+
+func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	deadline := time.Now().Add(rt.config.MaxElapsed)
+	var lastErr error
+
+	for attempt := 0; attempt <= rt.config.MaxRetries; attempt++ {
+		bodyReq := req
+		if attempt > 0 {
+			clone, err := cloneRequest(req)
+			if err != nil {
+				return nil, err
+			}
+			bodyReq = clone
+		}
+
+		resp, err := rt.next.RoundTrip(bodyReq)
+		if err == nil && !shouldRetryResponse(resp) {
+			return resp, nil
+		}
+
+		if err != nil {
+			lastErr = err
+			if !shouldRetryError(err) {
+				return nil, err
+			}
+		}
+
+		if attempt == rt.config.MaxRetries || time.Now().After(deadline) {
+			if err == nil {
+				return resp, nil
+			}
+			return nil, lastErr
+		}
+
+		delay := rt.nextDelay(resp, attempt)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if rt.config.OnRetry != nil {
+			rt.config.OnRetry(req.Method, req.URL.String(), attempt+1, delay)
+		}
+		rt.logger.Warn("retrying request", zap.String("method", req.Method), zap.String("uri", req.URL.String()), zap.Int("attempt", attempt+1), zap.Duration("delay", delay))
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, lastErr
+}
+
+func shouldRetryResponse(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func shouldRetryError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	return true
+}
+
+// nextDelay honors Retry-After on 429 responses (both delay-seconds and
+// HTTP-date forms), otherwise falls back to exponential backoff with
+// jitter.
+func (rt *retryRoundTripper) nextDelay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	backoff := rt.config.BaseDelay << attempt
+	if backoff > rt.config.MaxDelay {
+		backoff = rt.config.MaxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(backoff) + 1))
+	return backoff/2 + jitter/2
+}
+
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		if delay := time.Until(when); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
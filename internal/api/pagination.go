@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+// link is a single HAL-style pagination link as returned by Asgardeo list
+// endpoints, e.g. {"href": "...&startIndex=11", "rel": "next"}.
+type link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+// pageMeta is the pagination envelope shared by every Asgardeo list
+// endpoint, alongside the endpoint-specific resource array.
+type pageMeta struct {
+	StartIndex   int    `json:"startIndex"`
+	Count        int    `json:"count"`
+	TotalResults int    `json:"totalResults"`
+	Links        []link `json:"links"`
+}
+
+func (m pageMeta) nextLinkHref() string {
+	for _, l := range m.Links {
+		if l.Rel == "next" {
+			return l.Href
+		}
+	}
+	return ""
+}
+
+// PaginateOptions controls how Paginate walks a paginated list endpoint.
+type PaginateOptions struct {
+	// Limit caps how many results the server returns per page via the
+	// `count` query parameter. Zero uses the server's own default.
+	Limit int
+	// All follows every page until the list is exhausted. When false,
+	// Paginate returns after the first page.
+	All bool
+}
+
+// Paginate walks a paginated list endpoint, invoking fn once per page with
+// the page's raw JSON body. It follows the server's "next" link when
+// present, falling back to incrementing startIndex by count otherwise,
+// until the list is exhausted, opts.All is false, or fn returns an error.
+func (c *httpClient) Paginate(ctx context.Context, uri string, opts PaginateOptions, fn func(page json.RawMessage) error) error {
+	nextURI := withLimit(uri, opts.Limit)
+
+	for nextURI != "" {
+		request, err := c.NewRequest(ctx, http.MethodGet, nextURI, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create a new request: %w", err)
+		}
+		response, err := c.Do(request)
+		if err != nil {
+			c.logger.Error("failed to send the request with http client", zap.String("method", http.MethodGet), zap.String("uri", nextURI), zap.Error(err))
+			return fmt.Errorf("failed to send the request: %w", err)
+		}
+		raw, meta, err := readPage(response)
+		if err != nil {
+			c.logger.Error("received an error response from the server", zap.String("method", http.MethodGet), zap.String("uri", nextURI), zap.Error(err))
+			return err
+		}
+
+		if err := fn(raw); err != nil {
+			return err
+		}
+		if !opts.All {
+			return nil
+		}
+
+		nextURI = nextPageURI(nextURI, meta)
+	}
+	return nil
+}
+
+// Paginator is satisfied by httpClient and any fake that wants to exercise
+// Collect in tests without a real Paginate implementation.
+type Paginator interface {
+	Paginate(ctx context.Context, uri string, opts PaginateOptions, fn func(page json.RawMessage) error) error
+}
+
+// Collect drains every page of a paginated list endpoint into a single
+// slice, using opts for the per-page Limit (All is always forced on).
+// itemsKey is the JSON field the resource array is nested under (e.g.
+// "applications").
+func Collect[T any](ctx context.Context, c Paginator, uri, itemsKey string, opts PaginateOptions) ([]T, error) {
+	opts.All = true
+	var all []T
+	err := c.Paginate(ctx, uri, opts, func(page json.RawMessage) error {
+		var body map[string]json.RawMessage
+		if err := json.Unmarshal(page, &body); err != nil {
+			return fmt.Errorf("failed to parse page body: %w", err)
+		}
+		itemsRaw, ok := body[itemsKey]
+		if !ok {
+			return nil
+		}
+		var items []T
+		if err := json.Unmarshal(itemsRaw, &items); err != nil {
+			return fmt.Errorf("failed to parse page items: %w", err)
+		}
+		all = append(all, items...)
+		return nil
+	})
+	return all, err
+}
+
+func readPage(response *http.Response) (json.RawMessage, pageMeta, error) {
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, pageMeta{}, newError(response)
+	}
+	var raw json.RawMessage
+	if err := json.NewDecoder(response.Body).Decode(&raw); err != nil {
+		return nil, pageMeta{}, fmt.Errorf("failed to read the response body: %w", err)
+	}
+	var meta pageMeta
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, pageMeta{}, fmt.Errorf("failed to parse pagination metadata: %w", err)
+	}
+	return raw, meta, nil
+}
+
+func withLimit(uri string, limit int) string {
+	if limit <= 0 {
+		return uri
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	q := u.Query()
+	q.Set("count", strconv.Itoa(limit))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func nextPageURI(current string, meta pageMeta) string {
+	if href := meta.nextLinkHref(); href != "" {
+		return href
+	}
+	if meta.Count == 0 {
+		return ""
+	}
+	nextStart := meta.StartIndex + meta.Count
+	if meta.TotalResults > 0 && nextStart > meta.TotalResults {
+		return ""
+	}
+	u, err := url.Parse(current)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("startIndex", strconv.Itoa(nextStart))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
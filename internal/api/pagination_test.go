@@ -0,0 +1,44 @@
+package api
+
+import "testing"
+
+func TestNextPageURI(t *testing.T) {
+	tests := []struct {
+		name string
+		meta pageMeta
+		want string
+	}{
+		{
+			name: "uses the next link when present",
+			meta: pageMeta{
+				StartIndex: 1, Count: 10, TotalResults: 30,
+				Links: []link{{Rel: "next", Href: "https://api.example.com/apps?startIndex=11"}},
+			},
+			want: "https://api.example.com/apps?startIndex=11",
+		},
+		{
+			name: "advances startIndex by count, not count+1, without a next link",
+			meta: pageMeta{StartIndex: 1, Count: 10, TotalResults: 30},
+			want: "https://api.example.com/apps?startIndex=11",
+		},
+		{
+			name: "stops once startIndex+count passes totalResults",
+			meta: pageMeta{StartIndex: 21, Count: 10, TotalResults: 30},
+			want: "",
+		},
+		{
+			name: "stops when the page came back empty",
+			meta: pageMeta{StartIndex: 1, Count: 0, TotalResults: 0},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nextPageURI("https://api.example.com/apps?startIndex=1", tt.meta)
+			if got != tt.want {
+				t.Fatalf("nextPageURI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
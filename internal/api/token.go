@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TokenResult is the subset of tokenResponse callers outside this package
+// need after an OAuth2 exchange.
+type TokenResult struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int
+}
+
+// FetchClientCredentialsToken exchanges a management application's client
+// id/secret for an initial access (and, where the IdP issues one, refresh)
+// token via the client_credentials grant. This is what `asgardeo login`
+// uses to populate config.Tenant before any authenticated command can run.
+func FetchClientCredentialsToken(ctx context.Context, tokenURL, clientID, clientSecret string) (TokenResult, error) {
+	if tokenURL == "" {
+		return TokenResult{}, fmt.Errorf("a token URL is required to log in")
+	}
+	if clientID == "" || clientSecret == "" {
+		return TokenResult{}, fmt.Errorf("a client id and client secret are required to log in")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", clientID)
+	form.Set("client_secret", clientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return TokenResult{}, fmt.Errorf("failed to create login token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return TokenResult{}, fmt.Errorf("failed to send login token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return TokenResult{}, newError(resp)
+	}
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+		return TokenResult{}, fmt.Errorf("failed to decode login token response: %w", err)
+	}
+
+	return TokenResult{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
+	}, nil
+}
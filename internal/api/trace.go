@@ -0,0 +1,108 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"time"
+)
+
+// TraceEntry is a single sanitized request/response pair captured by the
+// tracing RoundTripper, ready to be logged or written as a HAR entry.
+type TraceEntry struct {
+	Method      string
+	URL         string
+	RequestDump []byte
+	StatusCode  int
+	Response    []byte
+	StartedAt   time.Time
+	Duration    time.Duration
+}
+
+// Tracer receives a TraceEntry for every request/response pair sent through
+// a client constructed with WithTracing.
+type Tracer interface {
+	Trace(entry TraceEntry)
+}
+
+// WithTracing installs a tracing RoundTripper directly around the base
+// transport, with retry middleware layered outside it, so every individual
+// attempt is captured as its own TraceEntry rather than just the final one.
+func WithTracing(tracer Tracer) Option {
+	return func(c *httpClient) {
+		c.tracer = tracer
+	}
+}
+
+// tracingRoundTripper dumps a sanitized copy of every request and response
+// to the configured Tracer: method, URL, headers with Authorization
+// redacted, body with known secret fields masked, status and duration.
+type tracingRoundTripper struct {
+	next   http.RoundTripper
+	tracer Tracer
+}
+
+func newTracingRoundTripper(next http.RoundTripper, tracer Tracer) *tracingRoundTripper {
+	return &tracingRoundTripper{next: next, tracer: tracer}
+}
+
+func (rt *tracingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	started := time.Now()
+	reqDump, _ := httputil.DumpRequestOut(cloneForDump(req), true)
+
+	response, err := rt.next.RoundTrip(req)
+	if err != nil {
+		rt.tracer.Trace(TraceEntry{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			RequestDump: sanitize(reqDump),
+			StartedAt:   started,
+			Duration:    time.Since(started),
+		})
+		return nil, err
+	}
+
+	respDump, _ := httputil.DumpResponse(response, true)
+
+	rt.tracer.Trace(TraceEntry{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		RequestDump: sanitize(reqDump),
+		StatusCode:  response.StatusCode,
+		Response:    sanitize(respDump),
+		StartedAt:   started,
+		Duration:    time.Since(started),
+	})
+
+	return response, nil
+}
+
+// cloneForDump clones req with a rewound body so DumpRequestOut doesn't
+// consume the body the real round trip still needs to send.
+func cloneForDump(req *http.Request) *http.Request {
+	clone, err := cloneRequest(req)
+	if err != nil {
+		return req
+	}
+	return clone
+}
+
+var secretFieldPattern = regexp.MustCompile(`(?i)"(access_token|refresh_token|client_secret|password|authorization)"\s*:\s*"[^"]*"`)
+
+var secretFormFieldPattern = regexp.MustCompile(`(?i)\b(access_token|refresh_token|client_secret|password)=[^&\s]*`)
+
+// sanitize redacts the Authorization header and masks known secret fields
+// in JSON and form-encoded bodies, so traces are safe to keep around or
+// share.
+func sanitize(dump []byte) []byte {
+	const redacted = `"$1":"***redacted***"`
+	masked := secretFieldPattern.ReplaceAll(dump, []byte(redacted))
+	masked = secretFormFieldPattern.ReplaceAll(masked, []byte("${1}=***redacted***"))
+	return redactAuthorizationHeader(masked)
+}
+
+var authHeaderPattern = regexp.MustCompile(`(?im)^(Authorization:\s*).*$`)
+
+func redactAuthorizationHeader(dump []byte) []byte {
+	return authHeaderPattern.ReplaceAll(dump, []byte("${1}***redacted***"))
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func applicationsCmd(cli *core.CLI) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "applications",
+		Short: "Manage Asgardeo applications",
+	}
+	command.AddCommand(applicationsListCmd(cli))
+	return command
+}
+
+func applicationsListCmd(cli *core.CLI) *cobra.Command {
+	var limit int
+	var all bool
+
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List applications registered in the tenant",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			applicationsAPI, ok := cli.API.(api.ApplicationsAPI)
+			if !ok {
+				return fmt.Errorf("API client does not support listing applications")
+			}
+			return listApplications(cmd, applicationsAPI, limit, all)
+		},
+	}
+
+	command.Flags().IntVar(&limit, "limit", 0, "Maximum number of applications to request per page (server default if unset)")
+	command.Flags().BoolVar(&all, "all", false, "Follow pagination and list every application in the tenant instead of just the first page")
+
+	return command
+}
+
+// listApplications lists applications registered in the tenant. limit, when
+// set, is applied to every page the request makes, whether or not all is
+// set: with all, every page via api.Collect; without it, just the first
+// page fetched through Paginate so --limit alone still has an effect.
+func listApplications(cmd *cobra.Command, applicationsAPI api.ApplicationsAPI, limit int, all bool) error {
+	uri := applicationsAPI.URI("applications")
+	opts := api.PaginateOptions{Limit: limit}
+
+	if all {
+		applications, err := api.Collect[api.Application](cmd.Context(), applicationsAPI, uri, "applications", opts)
+		if err != nil {
+			return fmt.Errorf("failed to list applications: %w", err)
+		}
+		return printApplications(cmd, applications)
+	}
+
+	var applications []api.Application
+	err := applicationsAPI.Paginate(cmd.Context(), uri, opts, func(page json.RawMessage) error {
+		var body struct {
+			Applications []api.Application `json:"applications"`
+		}
+		if err := json.Unmarshal(page, &body); err != nil {
+			return fmt.Errorf("failed to parse applications page: %w", err)
+		}
+		applications = body.Applications
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list applications: %w", err)
+	}
+	return printApplications(cmd, applications)
+}
+
+func printApplications(cmd *cobra.Command, applications []api.Application) error {
+	for _, application := range applications {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\t%s\t%s\n", application.ID, application.Name, application.ClientID)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api/apitest"
+	"github.com/spf13/cobra"
+)
+
+func TestListApplications(t *testing.T) {
+	tests := []struct {
+		name        string
+		limit       int
+		all         bool
+		programFake func(*apitest.FakeAPI)
+		wantOutput  string
+		wantCalls   int
+		wantErr     bool
+	}{
+		{
+			name: "lists applications",
+			programFake: func(fake *apitest.FakeAPI) {
+				fake.RespondWith("applications", `{"applications":[{"id":"1","name":"My App","clientId":"abc123"}]}`)
+			},
+			wantOutput: "1\tMy App\tabc123\n",
+			wantCalls:  1,
+		},
+		{
+			name: "no applications",
+			programFake: func(fake *apitest.FakeAPI) {
+				fake.RespondWith("applications", `{"applications":[]}`)
+			},
+			wantOutput: "",
+			wantCalls:  1,
+		},
+		{
+			name: "propagates API errors",
+			programFake: func(fake *apitest.FakeAPI) {
+				fake.FailWith("applications", errors.New("upstream unavailable"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "follows pagination with --all",
+			all:  true,
+			programFake: func(fake *apitest.FakeAPI) {
+				fake.RespondWithPages("applications",
+					`{"applications":[{"id":"1","name":"My App","clientId":"abc123"}],"startIndex":1,"count":1,"totalResults":2}`,
+					`{"applications":[{"id":"2","name":"Other App","clientId":"def456"}],"startIndex":2,"count":1,"totalResults":2}`,
+				)
+			},
+			wantOutput: "1\tMy App\tabc123\n2\tOther App\tdef456\n",
+			wantCalls:  2,
+		},
+		{
+			name: "single page without --all does not follow next page",
+			programFake: func(fake *apitest.FakeAPI) {
+				fake.RespondWithPages("applications",
+					`{"applications":[{"id":"1","name":"My App","clientId":"abc123"}],"startIndex":1,"count":1,"totalResults":2}`,
+					`{"applications":[{"id":"2","name":"Other App","clientId":"def456"}],"startIndex":2,"count":1,"totalResults":2}`,
+				)
+			},
+			wantOutput: "1\tMy App\tabc123\n",
+			wantCalls:  1,
+		},
+		{
+			name:  "--limit alone is applied without --all",
+			limit: 5,
+			programFake: func(fake *apitest.FakeAPI) {
+				fake.RespondWith("applications", `{"applications":[{"id":"1","name":"My App","clientId":"abc123"}]}`)
+			},
+			wantOutput: "1\tMy App\tabc123\n",
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := apitest.New()
+			tt.programFake(fake)
+
+			cmd := &cobra.Command{}
+			cmd.SetContext(context.Background())
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+
+			err := listApplications(cmd, fake, tt.limit, tt.all)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.String() != tt.wantOutput {
+				t.Fatalf("output = %q, want %q", out.String(), tt.wantOutput)
+			}
+			if len(fake.Calls) != tt.wantCalls {
+				t.Fatalf("expected %d calls to %q, got %+v", tt.wantCalls, "applications", fake.Calls)
+			}
+			if tt.limit > 0 && fake.Calls[0].Opts.Limit != tt.limit {
+				t.Fatalf("expected limit %d threaded through to Paginate, got %+v", tt.limit, fake.Calls[0].Opts)
+			}
+		})
+	}
+}
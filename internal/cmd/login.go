@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/config"
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func loginCmd(cli *core.CLI) *cobra.Command {
+	var tenantName, baseURL, region, clientID, clientSecret, tokenURL, accessToken, refreshToken string
+
+	command := &cobra.Command{
+		Use:   "login",
+		Short: "Authenticate the CLI against an Asgardeo tenant",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resolvedBaseURL, err := resolveLoginBaseURL(baseURL, region)
+			if err != nil {
+				return err
+			}
+			tenant := &config.Tenant{
+				Name:         tenantName,
+				BaseURL:      resolvedBaseURL,
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				TokenURL:     config.ResolveTokenURL(resolvedBaseURL, tokenURL),
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
+			}
+			if err := cli.Login(cmd.Context(), tenant); err != nil {
+				return fmt.Errorf("login failed: %w", err)
+			}
+			return nil
+		},
+	}
+
+	command.Flags().StringVar(&tenantName, "tenant", "", "Asgardeo organization/tenant name")
+	command.Flags().StringVar(&baseURL, "base-url", "", "Base URL of the Asgardeo deployment, for self-hosted or air-gapped environments")
+	command.Flags().StringVar(&region, "region", "", "Asgardeo region shorthand (dev, stage, prod, eu, us) instead of --base-url")
+	command.Flags().StringVar(&clientID, "client-id", "", "OAuth client id of the management application")
+	command.Flags().StringVar(&clientSecret, "client-secret", "", "OAuth client secret of the management application")
+	command.Flags().StringVar(&tokenURL, "token-url", "", "Token endpoint to authenticate against (defaults to <base-url>/oauth2/token)")
+	command.Flags().StringVar(&accessToken, "access-token", "", "Import an already-obtained access token instead of exchanging --client-id/--client-secret")
+	command.Flags().StringVar(&refreshToken, "refresh-token", "", "Import an already-obtained refresh token alongside --access-token")
+	command.MarkFlagsMutuallyExclusive("base-url", "region")
+
+	return command
+}
+
+// resolveLoginBaseURL turns the --base-url/--region flags into the base URL
+// that should be stored on the tenant, defaulting to the prod region when
+// neither is set.
+func resolveLoginBaseURL(baseURL, region string) (string, error) {
+	if baseURL != "" {
+		return baseURL, nil
+	}
+	if region == "" {
+		region = "prod"
+	}
+	return config.ResolveBaseURL(region)
+}
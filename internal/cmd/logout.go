@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func logoutCmd(cli *core.CLI) *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Remove the stored credentials for the active tenant",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cli.Logout(cmd.Context()); err != nil {
+				return fmt.Errorf("logout failed: %w", err)
+			}
+			return nil
+		},
+	}
+}
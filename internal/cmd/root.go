@@ -6,7 +6,9 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sync/atomic"
 
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
 	"github.com/shashimalcse/asgardeo-cli/internal/config"
 	"github.com/shashimalcse/asgardeo-cli/internal/core"
 	"github.com/spf13/cobra"
@@ -36,9 +38,37 @@ func Execute() {
 	cli := core.NewCLI(cfg, logger)
 	rootCmd := buildRootCmd(cli)
 	addSubCommands(rootCmd, cli)
+
+	var harWriter atomic.Pointer[api.HARWriter]
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	go handleSignals(cancel)
+	go handleSignals(cancel, func() {
+		if writer := harWriter.Load(); writer != nil {
+			writer.Flush()
+		}
+	})
+
+	rootCmd.PersistentFlags().BoolVar(&debugFlag, "debug", envDebugEnabled(), "Log every HTTP request/response (also: ASGARDEO_DEBUG=1)")
+	rootCmd.PersistentFlags().StringVar(&harFlag, "har", "", "Write sanitized HTTP traces as a HAR 1.2 archive to this file instead of the log file")
+	rootCmd.PersistentPreRunE = wrapPersistentPreRunE(rootCmd.PersistentPreRunE, func(cmd *cobra.Command, args []string) error {
+		if !debugFlag && !envDebugEnabled() {
+			return nil
+		}
+		devLogger, err := zap.NewDevelopmentConfig().Build()
+		if err != nil {
+			return fmt.Errorf("failed to build debug logger: %w", err)
+		}
+		cli.Logger = devLogger
+		if harFlag != "" {
+			writer := api.NewHARWriter(harFlag)
+			harWriter.Store(writer)
+			cli.Tracer = writer
+		} else {
+			cli.Tracer = logTracer{logger: logger}
+		}
+		return nil
+	})
+
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		logger.Error("Command execution failed", zap.Error(err))
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -46,6 +76,46 @@ func Execute() {
 	}
 }
 
+var (
+	debugFlag bool
+	harFlag   string
+)
+
+func envDebugEnabled() bool {
+	return os.Getenv("ASGARDEO_DEBUG") == "1"
+}
+
+// logTracer adapts a *zap.Logger into an api.Tracer, used when --debug is
+// set without --har to write traces to the log file.
+type logTracer struct {
+	logger *zap.Logger
+}
+
+func (t logTracer) Trace(entry api.TraceEntry) {
+	t.logger.Info("http trace",
+		zap.String("method", entry.Method),
+		zap.String("url", entry.URL),
+		zap.Int("status_code", entry.StatusCode),
+		zap.Duration("duration", entry.Duration),
+		zap.ByteString("request", entry.RequestDump),
+		zap.ByteString("response", entry.Response),
+	)
+}
+
+// wrapPersistentPreRunE runs extra before an existing PersistentPreRunE
+// (or standalone, if there wasn't one yet).
+func wrapPersistentPreRunE(existing func(*cobra.Command, []string) error, extra func(*cobra.Command, []string) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := extra(cmd, args); err != nil {
+			return err
+		}
+		if existing != nil {
+			return existing(cmd, args)
+		}
+		return nil
+	}
+}
+
 func buildRootCmd(cli *core.CLI) *cobra.Command {
 	rootCommand := &cobra.Command{
 		Use:           "asgardeo",
@@ -103,9 +173,12 @@ func configLogger() (*zap.Logger, error) {
 	return config.Build()
 }
 
-func handleSignals(cancel context.CancelFunc) {
+func handleSignals(cancel context.CancelFunc, beforeCancel func()) {
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt)
 	<-sigCh
+	if beforeCancel != nil {
+		beforeCancel()
+	}
 	cancel()
 }
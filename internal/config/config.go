@@ -0,0 +1,208 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultAPIPathTemplate is the path rendered under BaseURL for Asgardeo
+// cloud and compatible on-prem WSO2 Identity Server deployments. "{tenant}"
+// is replaced with the tenant's Name.
+const DefaultAPIPathTemplate = "t/{tenant}/api/server/v1"
+
+// Regions maps the short names accepted by `asgardeo login --region` to the
+// base URL of that Asgardeo deployment.
+var Regions = map[string]string{
+	"dev":   "https://dev.api.asgardeo.io/",
+	"stage": "https://stage.api.asgardeo.io/",
+	"prod":  "https://api.asgardeo.io/",
+	"eu":    "https://api.eu.asgardeo.io/",
+	"us":    "https://api.us.asgardeo.io/",
+}
+
+// ResolveBaseURL turns a `--region` shorthand into a full base URL. An
+// unrecognised region is returned as an error so callers can fall back to
+// `--base-url` for self-hosted deployments.
+func ResolveBaseURL(region string) (string, error) {
+	baseURL, ok := Regions[region]
+	if !ok {
+		return "", fmt.Errorf("unknown region %q, known regions: dev, stage, prod, eu, us", region)
+	}
+	return baseURL, nil
+}
+
+// DefaultTokenPath is appended to BaseURL to derive the token endpoint when
+// `login --token-url` isn't given.
+const DefaultTokenPath = "oauth2/token"
+
+// ResolveTokenURL returns tokenURL unchanged when set, otherwise derives it
+// from baseURL + DefaultTokenPath.
+func ResolveTokenURL(baseURL, tokenURL string) string {
+	if tokenURL != "" {
+		return tokenURL
+	}
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return baseURL + DefaultTokenPath
+}
+
+// Tenant holds the credentials and connection details for a single Asgardeo
+// organization that the CLI has logged into.
+type Tenant struct {
+	Name         string `json:"name"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	TokenURL     string `json:"token_url"`
+
+	// TokenExpiresAt is when AccessToken expires, so a fresh httpClient can
+	// seed its proactive-refresh deadline without first eating a 401.
+	TokenExpiresAt time.Time `json:"token_expires_at,omitempty"`
+
+	// BaseURL is the scheme+host the CLI talks to, e.g.
+	// "https://api.asgardeo.io/" or a self-hosted WSO2 IS host. Empty means
+	// the default Asgardeo prod cloud.
+	BaseURL string `json:"base_url,omitempty"`
+	// APIPathTemplate overrides DefaultAPIPathTemplate for deployments that
+	// mount the server API under a different path.
+	APIPathTemplate string `json:"api_path_template,omitempty"`
+}
+
+// GetAccessToken returns the tenant's currently stored access token.
+func (t *Tenant) GetAccessToken() string {
+	return t.AccessToken
+}
+
+// Config is the on-disk representation of `~/.asgardeo/config.json`. All
+// reads and writes must go through the methods below, which take care of
+// locking and persisting to disk.
+type Config struct {
+	mu            sync.Mutex `json:"-"`
+	path          string
+	logger        *zap.Logger
+	DefaultTenant string             `json:"default_tenant"`
+	Tenants       map[string]*Tenant `json:"tenants"`
+}
+
+func defaultConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(home, ".asgardeo", "config.json"), nil
+}
+
+// NewConfig loads the CLI configuration from disk, creating an empty one if
+// it does not yet exist.
+func NewConfig(logger *zap.Logger) *Config {
+	path, err := defaultConfigPath()
+	if err != nil {
+		logger.Error("failed to resolve config path", zap.Error(err))
+		return &Config{logger: logger, Tenants: map[string]*Tenant{}}
+	}
+	cfg := &Config{path: path, logger: logger, Tenants: map[string]*Tenant{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg
+	}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		logger.Error("failed to parse config file", zap.String("path", path), zap.Error(err))
+	}
+	return cfg
+}
+
+// GetTenant returns the stored tenant for the given domain, falling back to
+// the default tenant when domain is empty.
+func (c *Config) GetTenant(domain string) (*Tenant, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if domain == "" {
+		domain = c.DefaultTenant
+	}
+	tenant, ok := c.Tenants[domain]
+	if !ok {
+		return nil, fmt.Errorf("no tenant found for domain %q, run `asgardeo login` first", domain)
+	}
+	return tenant, nil
+}
+
+// SetTenant upserts a tenant and persists the config to disk.
+func (c *Config) SetTenant(domain string, tenant *Tenant) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Tenants == nil {
+		c.Tenants = map[string]*Tenant{}
+	}
+	c.Tenants[domain] = tenant
+	if c.DefaultTenant == "" {
+		c.DefaultTenant = domain
+	}
+	return c.save()
+}
+
+// UpdateTenantTokens persists a refreshed access/refresh token pair and the
+// new access token's expiry for the given tenant domain, falling back to
+// the default tenant when domain is empty, the same way GetTenant does.
+func (c *Config) UpdateTenantTokens(domain, accessToken, refreshToken string, expiresAt time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if domain == "" {
+		domain = c.DefaultTenant
+	}
+	tenant, ok := c.Tenants[domain]
+	if !ok {
+		return fmt.Errorf("no tenant found for domain %q", domain)
+	}
+	tenant.AccessToken = accessToken
+	if refreshToken != "" {
+		tenant.RefreshToken = refreshToken
+	}
+	tenant.TokenExpiresAt = expiresAt
+	return c.save()
+}
+
+// RemoveTenant deletes the stored tenant for domain, falling back to the
+// default tenant when domain is empty. If the removed tenant was the
+// default, DefaultTenant is cleared too.
+func (c *Config) RemoveTenant(domain string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if domain == "" {
+		domain = c.DefaultTenant
+	}
+	if _, ok := c.Tenants[domain]; !ok {
+		return fmt.Errorf("no tenant found for domain %q", domain)
+	}
+	delete(c.Tenants, domain)
+	if c.DefaultTenant == domain {
+		c.DefaultTenant = ""
+	}
+	return c.save()
+}
+
+// save writes the config to disk. Callers must hold c.mu.
+func (c *Config) save() error {
+	if c.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := os.WriteFile(c.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
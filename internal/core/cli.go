@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shashimalcse/asgardeo-cli/internal/api"
+	"github.com/shashimalcse/asgardeo-cli/internal/config"
+	"go.uber.org/zap"
+)
+
+// CLI bundles the dependencies shared by every command: configuration,
+// logging and the authenticated API client for the active tenant.
+type CLI struct {
+	Config *config.Config
+	Logger *zap.Logger
+
+	// Tracer, when set (via --debug/--har), is installed on every API
+	// client this CLI builds.
+	Tracer api.Tracer
+
+	tenantDomain string
+	API          api.API
+}
+
+func NewCLI(cfg *config.Config, logger *zap.Logger) *CLI {
+	return &CLI{Config: cfg, Logger: logger}
+}
+
+// SetupWithAuthentication resolves the active tenant and builds the API
+// client used by commands that require an authenticated session.
+func (c *CLI) SetupWithAuthentication(ctx context.Context) error {
+	var opts []api.Option
+	if c.Tracer != nil {
+		opts = append(opts, api.WithTracing(c.Tracer))
+	}
+	httpClientAPI, err := api.NewHTTPClientAPI(c.Config, c.tenantDomain, c.Logger, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to set up authenticated API client: %w", err)
+	}
+	c.API = httpClientAPI
+	return nil
+}
+
+// Login stores the tenant's credentials and exchanges them for an initial
+// access/refresh token pair, unless the caller already imported one via
+// --access-token.
+func (c *CLI) Login(ctx context.Context, tenant *config.Tenant) error {
+	if tenant.Name == "" {
+		return fmt.Errorf("a --tenant name is required")
+	}
+	if tenant.AccessToken == "" {
+		tokens, err := api.FetchClientCredentialsToken(ctx, tenant.TokenURL, tenant.ClientID, tenant.ClientSecret)
+		if err != nil {
+			return fmt.Errorf("failed to obtain an access token: %w", err)
+		}
+		tenant.AccessToken = tokens.AccessToken
+		tenant.RefreshToken = tokens.RefreshToken
+		tenant.TokenExpiresAt = time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+	}
+	if err := c.Config.SetTenant(tenant.Name, tenant); err != nil {
+		return fmt.Errorf("failed to persist tenant: %w", err)
+	}
+	c.tenantDomain = tenant.Name
+	return nil
+}
+
+// Logout removes the stored credentials for the active tenant.
+func (c *CLI) Logout(ctx context.Context) error {
+	if err := c.Config.RemoveTenant(c.tenantDomain); err != nil {
+		return fmt.Errorf("failed to remove tenant: %w", err)
+	}
+	return nil
+}